@@ -12,12 +12,54 @@ func main() {
 	locc := new(businesslogic.LetterOfCredit)
 	locc.SetNamespace("org.example.letterofcredit")
 	locc.SetTransactionContextHandler(new(helpers.TransactionContext))
+	locc.SetAfterTransaction(helpers.AfterTransaction)
+
+	dc := new(businesslogic.Drafts)
+	dc.SetNamespace("org.example.drafts")
+	dc.SetTransactionContextHandler(new(helpers.TransactionContext))
+	dc.SetAfterTransaction(helpers.AfterTransaction)
+
+	bac := new(businesslogic.BankAdmin)
+	bac.SetNamespace("org.example.bankadmin")
+	bac.SetTransactionContextHandler(new(helpers.TransactionContext))
+	bac.SetAfterTransaction(helpers.AfterTransaction)
+
+	lc := new(businesslogic.Ledger)
+	lc.SetNamespace("org.example.ledger")
+	lc.SetTransactionContextHandler(new(helpers.TransactionContext))
+	lc.SetAfterTransaction(helpers.AfterTransaction)
+
+	fc := new(businesslogic.Fees)
+	fc.SetNamespace("org.example.fees")
+	fc.SetTransactionContextHandler(new(helpers.TransactionContext))
+	fc.SetAfterTransaction(helpers.AfterTransaction)
+
+	fxc := new(businesslogic.FXRates)
+	fxc.SetNamespace("org.example.fxrates")
+	fxc.SetTransactionContextHandler(new(helpers.TransactionContext))
+	fxc.SetAfterTransaction(helpers.AfterTransaction)
+
+	oc := new(businesslogic.Oracles)
+	oc.SetNamespace("org.example.oracles")
+	oc.SetTransactionContextHandler(new(helpers.TransactionContext))
+	oc.SetAfterTransaction(helpers.AfterTransaction)
+
+	dgc := new(businesslogic.Delegations)
+	dgc.SetNamespace("org.example.delegations")
+	dgc.SetTransactionContextHandler(new(helpers.TransactionContext))
+	dgc.SetAfterTransaction(helpers.AfterTransaction)
+
+	cc := new(businesslogic.Compliance)
+	cc.SetNamespace("org.example.compliance")
+	cc.SetTransactionContextHandler(new(helpers.TransactionContext))
+	cc.SetAfterTransaction(helpers.AfterTransaction)
 
 	pc := new(businesslogic.Participants)
 	pc.SetNamespace("org.system.participants")
 	pc.SetTransactionContextHandler(new(helpers.TransactionContext))
+	pc.SetAfterTransaction(helpers.AfterTransaction)
 
-	if err := contractapi.CreateNewChaincode(locc, pc); err != nil {
+	if err := contractapi.CreateNewChaincode(locc, dc, bac, lc, fc, fxc, oc, dgc, cc, pc); err != nil {
 		fmt.Printf("Error starting LettersOfCredit chaincode: %s", err)
 	}
 }